@@ -0,0 +1,271 @@
+// Package arc implements the Adaptive Replacement Cache (ARC) algorithm
+// (Megiddo & Modha), an eviction policy sibling to the LFU cache in
+// lfucache/lfu that balances recency and frequency automatically instead
+// of requiring a fixed policy choice.
+package arc
+
+import (
+	"iter"
+	"lfucache/internal/linkedlist"
+	"lfucache/lfu"
+)
+
+// Cache implements the Adaptive Replacement Cache algorithm. It keeps two
+// LRU lists of cached entries, T1 (seen once) and T2 (seen at least twice),
+// each shadowed by a ghost list of evicted keys, B1 and B2. A target size p
+// for T1 is adapted on every ghost-list hit, so the balance between
+// recency (T1) and frequency (T2) tracks the workload automatically.
+type Cache[K comparable, V any] struct {
+	capacity int
+	p        int // target size for T1, 0 <= p <= capacity.
+
+	t1, t2 linkedlist.ListInterface[*node[K, V]]
+	b1, b2 linkedlist.ListInterface[K]
+
+	t1Index map[K]*linkedlist.Element[*node[K, V]]
+	t2Index map[K]*linkedlist.Element[*node[K, V]]
+	b1Index map[K]*linkedlist.Element[K]
+	b2Index map[K]*linkedlist.Element[K]
+
+	defaultValue V
+}
+
+// node stores the cached value alongside its key, so a T1/T2 list element
+// can be evicted to a ghost list without a second map lookup.
+type node[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+var _ lfu.Cache[int, int] = (*Cache[int, int])(nil)
+
+// New initializes an ARC cache with the given capacity.
+// If capacity is zero, the cache will use lfu.DefaultCapacity.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	if capacity < 0 {
+		panic("Negative capacity does not supported.")
+	}
+	if capacity == 0 {
+		capacity = lfu.DefaultCapacity
+	}
+	return &Cache[K, V]{
+		capacity: capacity,
+		t1:       linkedlist.NewList[*node[K, V]](),
+		t2:       linkedlist.NewList[*node[K, V]](),
+		b1:       linkedlist.NewList[K](),
+		b2:       linkedlist.NewList[K](),
+		t1Index:  make(map[K]*linkedlist.Element[*node[K, V]]),
+		t2Index:  make(map[K]*linkedlist.Element[*node[K, V]]),
+		b1Index:  make(map[K]*linkedlist.Element[K]),
+		b2Index:  make(map[K]*linkedlist.Element[K]),
+	}
+}
+
+// pushFront inserts e as the new MRU (most recently used) element of l.
+func pushFront[E any](l linkedlist.ListInterface[E], e *linkedlist.Element[E]) {
+	if l.Len() == 0 {
+		l.Add(e)
+		return
+	}
+	l.AddBefore(e, l.Front())
+}
+
+// Get returns the value of the key if present in T1 or T2, moving it to the
+// MRU end of T2 either way, since being requested again marks it frequent.
+// Ghost hits (ghost lists hold no values) and true misses both return
+// lfu.ErrKeyNotFound; only Put can resurrect a ghost entry.
+func (c *Cache[K, V]) Get(key K) (V, error) {
+	if el, ok := c.t1Index[key]; ok {
+		c.t1.Remove(el)
+		delete(c.t1Index, key)
+		pushFront(c.t2, el)
+		c.t2Index[key] = el
+		return el.Value.value, nil
+	}
+	if el, ok := c.t2Index[key]; ok {
+		c.t2.Remove(el)
+		pushFront(c.t2, el)
+		return el.Value.value, nil
+	}
+	return c.defaultValue, lfu.ErrKeyNotFound
+}
+
+// Put updates the value of the key if present, or inserts the key if not
+// already present, following the ARC replacement algorithm: a ghost-list
+// hit (B1 or B2) adapts p toward recency or frequency before replacing and
+// promoting the key to T2; a full miss replaces as needed before inserting
+// into T1.
+func (c *Cache[K, V]) Put(key K, value V) {
+	if el, ok := c.t1Index[key]; ok {
+		el.Value.value = value
+		c.t1.Remove(el)
+		delete(c.t1Index, key)
+		pushFront(c.t2, el)
+		c.t2Index[key] = el
+		return
+	}
+	if el, ok := c.t2Index[key]; ok {
+		el.Value.value = value
+		c.t2.Remove(el)
+		pushFront(c.t2, el)
+		return
+	}
+
+	if _, ok := c.b1Index[key]; ok {
+		delta := 1
+		if len(c.b1Index) > 0 && len(c.b2Index) > len(c.b1Index) {
+			delta = len(c.b2Index) / len(c.b1Index)
+		}
+		c.p = min(c.capacity, c.p+delta)
+		c.replace(key)
+		c.removeGhost(c.b1, c.b1Index, key)
+		c.insertT2(key, value)
+		return
+	}
+
+	if _, ok := c.b2Index[key]; ok {
+		delta := 1
+		if len(c.b2Index) > 0 && len(c.b1Index) > len(c.b2Index) {
+			delta = len(c.b1Index) / len(c.b2Index)
+		}
+		c.p = max(0, c.p-delta)
+		c.replace(key)
+		c.removeGhost(c.b2, c.b2Index, key)
+		c.insertT2(key, value)
+		return
+	}
+
+	// Full miss: make room per the ARC case III/IV bookkeeping, then insert
+	// the new entry at the MRU end of T1.
+	t1Len, b1Len := len(c.t1Index), len(c.b1Index)
+	switch {
+	case t1Len+b1Len == c.capacity:
+		if t1Len < c.capacity {
+			c.evictGhost(c.b1, c.b1Index)
+			c.replace(key)
+		} else {
+			c.evictLRU(c.t1, c.t1Index)
+		}
+	case t1Len+b1Len < c.capacity:
+		total := t1Len + len(c.t2Index) + b1Len + len(c.b2Index)
+		if total >= c.capacity {
+			if total == 2*c.capacity {
+				c.evictGhost(c.b2, c.b2Index)
+			}
+			c.replace(key)
+		}
+	}
+	c.insertT1(key, value)
+}
+
+// replace evicts the LRU entry of T1 to B1, unless T1 is within its target
+// size p and key is not a B2 ghost, in which case the LRU entry of T2 is
+// evicted to B2 instead.
+func (c *Cache[K, V]) replace(key K) {
+	_, keyInB2 := c.b2Index[key]
+	t1Len := len(c.t1Index)
+	if t1Len > 0 && (t1Len > c.p || (keyInB2 && t1Len == c.p)) {
+		c.moveLRU(c.t1, c.t1Index, c.b1, c.b1Index)
+	} else {
+		c.moveLRU(c.t2, c.t2Index, c.b2, c.b2Index)
+	}
+}
+
+// moveLRU evicts the LRU entry of src to the MRU end of the ghost list dst,
+// trimming dst back down to capacity afterward.
+func (c *Cache[K, V]) moveLRU(
+	src linkedlist.ListInterface[*node[K, V]], srcIndex map[K]*linkedlist.Element[*node[K, V]],
+	dst linkedlist.ListInterface[K], dstIndex map[K]*linkedlist.Element[K],
+) {
+	victim := src.PopBack()
+	if victim == nil {
+		return
+	}
+	delete(srcIndex, victim.Value.key)
+	ghost := linkedlist.NewElement(victim.Value.key)
+	pushFront(dst, ghost)
+	dstIndex[victim.Value.key] = ghost
+	for dst.Len() > c.capacity {
+		stale := dst.PopBack()
+		delete(dstIndex, stale.Value)
+	}
+}
+
+// evictLRU permanently discards the LRU entry of a T1/T2 list, with no
+// corresponding ghost entry created.
+func (c *Cache[K, V]) evictLRU(l linkedlist.ListInterface[*node[K, V]], index map[K]*linkedlist.Element[*node[K, V]]) {
+	if victim := l.PopBack(); victim != nil {
+		delete(index, victim.Value.key)
+	}
+}
+
+// evictGhost discards the LRU entry of a ghost list to make room, without
+// touching T1/T2.
+func (c *Cache[K, V]) evictGhost(l linkedlist.ListInterface[K], index map[K]*linkedlist.Element[K]) {
+	if victim := l.PopBack(); victim != nil {
+		delete(index, victim.Value)
+	}
+}
+
+// removeGhost removes key's specific entry from a ghost list, used once a
+// ghost hit is about to be promoted back into the cache.
+func (c *Cache[K, V]) removeGhost(l linkedlist.ListInterface[K], index map[K]*linkedlist.Element[K], key K) {
+	if el, ok := index[key]; ok {
+		l.Remove(el)
+		delete(index, key)
+	}
+}
+
+func (c *Cache[K, V]) insertT1(key K, value V) {
+	el := linkedlist.NewElement(&node[K, V]{key: key, value: value})
+	pushFront(c.t1, el)
+	c.t1Index[key] = el
+}
+
+func (c *Cache[K, V]) insertT2(key K, value V) {
+	el := linkedlist.NewElement(&node[K, V]{key: key, value: value})
+	pushFront(c.t2, el)
+	c.t2Index[key] = el
+}
+
+// All returns an iterator over T2 then T1, each from MRU to LRU: entries
+// seen at least twice (T2) are considered more frequent than entries seen
+// once (T1), mirroring the descending-frequency order of lfu.CacheImpl.
+func (c *Cache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := range c.t2.Iterator() {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+		for n := range c.t1.Iterator() {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of entries currently cached (T1 plus T2); ghost
+// entries in B1/B2 hold no values and are not counted.
+func (c *Cache[K, V]) Size() int {
+	return len(c.t1Index) + len(c.t2Index)
+}
+
+// Capacity returns the cache capacity.
+func (c *Cache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// GetKeyFrequency returns 1 for a key in T1 (seen once) or 2 for a key in T2
+// (seen at least twice), approximating lfu.CacheImpl's frequency counter
+// since ARC does not track an exact hit count.
+func (c *Cache[K, V]) GetKeyFrequency(key K) (int, error) {
+	if _, ok := c.t1Index[key]; ok {
+		return 1, nil
+	}
+	if _, ok := c.t2Index[key]; ok {
+		return 2, nil
+	}
+	return 0, lfu.ErrKeyNotFound
+}
@@ -0,0 +1,81 @@
+package arc
+
+import (
+	"lfucache/lfu"
+	"testing"
+)
+
+func TestGetMiss(t *testing.T) {
+	c := New[string, int](2)
+	if _, err := c.Get("missing"); err != lfu.ErrKeyNotFound {
+		t.Fatalf("Get(missing) err = %v, want ErrKeyNotFound", err)
+	}
+}
+
+func TestPutGetRoundTrip(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+
+	got, err := c.Get("a")
+	if err != nil {
+		t.Fatalf("Get(a) err = %v, want nil", err)
+	}
+	if got != 1 {
+		t.Errorf("Get(a) = %d, want 1", got)
+	}
+	if freq, err := c.GetKeyFrequency("a"); err != nil || freq != 2 {
+		t.Errorf("GetKeyFrequency(a) = (%d, %v), want (2, nil)", freq, err)
+	}
+}
+
+func TestGhostHitResurrectsIntoT2(t *testing.T) {
+	c := New[int, string](2)
+	c.Put(1, "one")
+	c.Put(2, "two")
+	c.Get(2) // promote 2 into T2, leaving 1 alone in T1
+
+	// T1(1) + T2(2) already total the capacity, so this miss replaces: 1
+	// (T1's only, and therefore LRU, entry) moves to the B1 ghost list.
+	c.Put(3, "three")
+	if _, err := c.Get(1); err != lfu.ErrKeyNotFound {
+		t.Fatalf("Get(1) after replace err = %v, want ErrKeyNotFound", err)
+	}
+	if freq, _ := c.GetKeyFrequency(1); freq != 0 {
+		t.Errorf("GetKeyFrequency(1) after replace = %d, want 0 (ghost entries hold no frequency)", freq)
+	}
+
+	// The B1 ghost hit resurrects the key straight into T2.
+	c.Put(1, "one-again")
+	if freq, err := c.GetKeyFrequency(1); err != nil || freq != 2 {
+		t.Errorf("GetKeyFrequency(1) after ghost hit = (%d, %v), want (2, nil)", freq, err)
+	}
+	got, err := c.Get(1)
+	if err != nil || got != "one-again" {
+		t.Errorf("Get(1) = (%q, %v), want (\"one-again\", nil)", got, err)
+	}
+}
+
+func TestSizeNeverExceedsCapacity(t *testing.T) {
+	c := New[int, int](3)
+	for i := 0; i < 10; i++ {
+		c.Put(i, i)
+		if got := c.Size(); got > c.Capacity() {
+			t.Fatalf("Size() = %d after Put(%d), want <= Capacity() = %d", got, i, c.Capacity())
+		}
+	}
+}
+
+func TestAllOrdersT2BeforeT1(t *testing.T) {
+	c := New[int, int](4)
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Get(1) // promotes 1 into T2
+
+	var order []int
+	for k := range c.All() {
+		order = append(order, k)
+	}
+	if len(order) != 2 || order[0] != 1 || order[1] != 2 {
+		t.Errorf("All() order = %v, want [1 2] (T2 before T1)", order)
+	}
+}
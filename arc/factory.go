@@ -0,0 +1,27 @@
+package arc
+
+import "lfucache/lfu"
+
+// Policy selects the eviction policy a cache built via NewCache uses.
+type Policy int
+
+const (
+	// PolicyLFU builds a plain least-frequently-used cache (lfu.New).
+	PolicyLFU Policy = iota
+	// PolicyARC builds an Adaptive Replacement Cache (New).
+	PolicyARC
+)
+
+// NewCache builds a cache of the given capacity behind the shared
+// lfu.Cache[K, V] interface, letting callers swap eviction policies (LFU vs
+// ARC) through a single factory instead of hardcoding a constructor. It
+// lives in this package, not lfucache/lfu, since arc already imports lfu and
+// lfu importing arc back would cycle.
+func NewCache[K comparable, V any](policy Policy, capacity int) lfu.Cache[K, V] {
+	switch policy {
+	case PolicyARC:
+		return New[K, V](capacity)
+	default:
+		return lfu.New[K, V](capacity)
+	}
+}
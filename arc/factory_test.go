@@ -0,0 +1,23 @@
+package arc
+
+import "testing"
+
+func TestNewCachePolicies(t *testing.T) {
+	lfuCache := NewCache[string, int](PolicyLFU, 2)
+	if _, ok := lfuCache.(*Cache[string, int]); ok {
+		t.Fatalf("NewCache(PolicyLFU) returned an *arc.Cache, want an lfu cache")
+	}
+	lfuCache.Put("a", 1)
+	if got, err := lfuCache.Get("a"); err != nil || got != 1 {
+		t.Errorf("Get(a) = (%d, %v), want (1, nil)", got, err)
+	}
+
+	arcCache := NewCache[string, int](PolicyARC, 2)
+	if _, ok := arcCache.(*Cache[string, int]); !ok {
+		t.Fatalf("NewCache(PolicyARC) = %T, want *arc.Cache", arcCache)
+	}
+	arcCache.Put("a", 1)
+	if got, err := arcCache.Get("a"); err != nil || got != 1 {
+		t.Errorf("Get(a) = (%d, %v), want (1, nil)", got, err)
+	}
+}
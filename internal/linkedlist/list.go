@@ -11,7 +11,6 @@ type ListInterface[E any] interface {
 	Front() *Element[E]
 	Back() *Element[E]
 	AddBefore(newElement, existingElement *Element[E])
-	ReplaceDeletedElement(newElement, deletedElement *Element[E])
 	Iterator() iter.Seq[E]
 	Root() *Element[E]
 }
@@ -144,19 +143,6 @@ func (l *List[E]) Back() *Element[E] {
 	return l.root.prev
 }
 
-// ReplaceDeletedElement pick the node between parent and child
-// of the deleted node and increase List length.
-func (l *List[E]) ReplaceDeletedElement(elementToAdd *Element[E], deletedElement *Element[E]) {
-	if l.len == 0 {
-		return
-	}
-	elementToAdd.next = deletedElement.next
-	elementToAdd.prev = deletedElement.prev
-	deletedElement.prev.next = elementToAdd
-	deletedElement.next.prev = elementToAdd
-	l.len++ // Increment the List length.
-}
-
 // Iterator returns iterator function witch iterates all List elements in the order they are stored.
 func (l *List[E]) Iterator() iter.Seq[E] {
 	return func(yield func(E) bool) {
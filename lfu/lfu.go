@@ -0,0 +1,637 @@
+package lfu
+
+import (
+	"errors"
+	"iter"
+	"lfucache/internal/linkedlist"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Main algorithm idea: usage of:
+// 1) An outer linkedlist of freqEntry nodes, one per distinct frequency
+// currently in use, ordered by descending frequency (Front is highest).
+// 2) Each freqEntry owns its own linkedlist of the items at that frequency,
+// ordered by recency of use (Front is most recently used).
+// 3) Key to item map, and an item -> freqEntry pointer on every node, so
+// incrementing a frequency is an O(1) unlink/relink between two lists
+// instead of a search.
+
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrSizeExceedsCapacity is returned by PutWeighted when a single entry's
+// weight is larger than the byte-weighted cache's maxBytes, so it could
+// never fit regardless of what else is evicted.
+var ErrSizeExceedsCapacity = errors.New("entry weight exceeds cache capacity")
+
+const DefaultCapacity = 5
+
+// Cache
+// O(capacity) memory
+type Cache[K comparable, V any] interface {
+	// Get returns the value of the key if the key exists in the cache,
+	// otherwise, returns ErrKeyNotFound.
+	//
+	// O(1)
+	Get(key K) (V, error)
+
+	// Put updates the value of the key if present, or inserts the key if not already present.
+	//
+	// When the cache reaches its capacity, it should invalidate and remove the least frequently used key
+	// before inserting a new item. For this problem, when there is a tie
+	// (i.e., two or more keys with the same frequency), the least recently used key would be invalidated.
+	//
+	// O(1)
+	Put(key K, value V)
+
+	// All returns the iterator in descending order of frequency.
+	// If two or more keys have the same frequency, the most recently used key will be listed first.
+	//
+	// O(capacity)
+	All() iter.Seq2[K, V]
+
+	// Size returns the cache size.
+	//
+	// O(1)
+	Size() int
+
+	// Capacity returns the cache capacity.
+	//
+	// O(1)
+	Capacity() int
+
+	// GetKeyFrequency returns the element's frequency if the key exists in the cache,
+	// otherwise, returns ErrKeyNotFound.
+	//
+	// O(1)
+	GetKeyFrequency(key K) (int, error)
+}
+
+// freqEntry groups every cached item currently at the same frequency into
+// its own items list, so promoting an item only ever touches its old and
+// new freqEntry, never the rest of the cache.
+type freqEntry[K comparable, V any] struct {
+	freq  int
+	items linkedlist.ListInterface[*node[K, V]]
+}
+
+// CacheImpl represents LFU cache implementation
+type CacheImpl[K comparable, V any] struct {
+	freqList     linkedlist.ListInterface[*freqEntry[K, V]]
+	freqIndex    map[int]*linkedlist.Element[*freqEntry[K, V]]
+	keyToElement map[K]*linkedlist.Element[*node[K, V]]
+	capacity     int
+	size         int
+	defaultValue V
+
+	ttl             time.Duration
+	janitorInterval time.Duration
+	stopCh          chan struct{}
+	closeOnce       sync.Once
+	locker          sync.Locker
+
+	weigher      func(K, V) int
+	maxBytes     int64
+	currentBytes int64
+
+	store     Store[K, V]
+	writeMode WriteMode
+
+	onEvict func(key K, value V, freq int)
+
+	hits       atomic.Int64
+	misses     atomic.Int64
+	evictions  atomic.Int64
+	insertions atomic.Int64
+}
+
+// Stats is a point-in-time snapshot of a CacheImpl's activity counters, as
+// returned by CacheImpl.Stats.
+type Stats struct {
+	Hits       int64
+	Misses     int64
+	Evictions  int64
+	Insertions int64
+}
+
+// NewWithWeight initializes a byte-weighted LFU cache: instead of bounding
+// the number of entries, Put (via PutWeighted) evicts least-frequently-used
+// entries until weigher(key, value) fits within maxBytes. Use Bytes to
+// inspect current byte usage alongside Size. Capacity reports maxBytes
+// instead of an entry count, since a byte-weighted cache has no fixed one.
+func NewWithWeight[K comparable, V any](maxBytes int64, weigher func(K, V) int) *CacheImpl[K, V] {
+	c := New[K, V](DefaultCapacity)
+	c.weigher = weigher
+	c.maxBytes = maxBytes
+	c.capacity = int(maxBytes)
+	return c
+}
+
+// Option configures optional behavior of a CacheImpl at construction time.
+type Option[K comparable, V any] func(*CacheImpl[K, V])
+
+// WithTTL sets the default time-to-live applied to entries inserted via Put.
+// Entries never expire if ttl is zero (the default). PutWithTTL overrides
+// this default on a per-entry basis.
+func WithTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(c *CacheImpl[K, V]) {
+		c.ttl = ttl
+	}
+}
+
+// WithJanitor starts a background goroutine that sweeps expired entries
+// every interval, so they are reclaimed even without a Get/Put touching
+// them. The goroutine runs until Close is called. The cache itself is not
+// safe for concurrent use, so a cache combining WithJanitor with concurrent
+// access from other goroutines should be constructed via NewSync(capacity,
+// WithTTL(ttl), WithJanitor(interval)) instead of New: NewSync makes the
+// janitor acquire its mutex around every sweep, the same as Get and Put.
+func WithJanitor[K comparable, V any](interval time.Duration) Option[K, V] {
+	return func(c *CacheImpl[K, V]) {
+		c.janitorInterval = interval
+	}
+}
+
+// withLocker makes the janitor goroutine acquire l around every sweep,
+// instead of mutating freqList/keyToElement/size unsynchronized. It is
+// unexported and only used by NewSync, since a bare CacheImpl has no mutex
+// of its own to share.
+func withLocker[K comparable, V any](l sync.Locker) Option[K, V] {
+	return func(c *CacheImpl[K, V]) {
+		c.locker = l
+	}
+}
+
+// WithOnEvict registers a callback fired whenever an entry is removed from
+// the cache, whether by capacity eviction or TTL expiration, passing the
+// frequency the entry had reached at the time of removal. The callback runs
+// synchronously on the goroutine that triggered the removal (including the
+// janitor goroutine started by WithJanitor), so it should not block. An
+// explicit Delete does not fire it.
+func WithOnEvict[K comparable, V any](fn func(key K, value V, freq int)) Option[K, V] {
+	return func(c *CacheImpl[K, V]) {
+		c.onEvict = fn
+	}
+}
+
+// New initializes the cache with the given capacity and options.
+// If capacity is zero, the cache will use DefaultCapacity.
+func New[K comparable, V any](capacity int, opts ...Option[K, V]) *CacheImpl[K, V] {
+	if capacity < 0 {
+		panic("Negative capacity does not supported.")
+	}
+	if capacity == 0 {
+		capacity = DefaultCapacity
+	}
+	c := &CacheImpl[K, V]{
+		freqList:     linkedlist.NewList[*freqEntry[K, V]](),
+		freqIndex:    make(map[int]*linkedlist.Element[*freqEntry[K, V]]),
+		keyToElement: make(map[K]*linkedlist.Element[*node[K, V]], capacity),
+		capacity:     capacity,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.janitorInterval > 0 {
+		c.startJanitor()
+	}
+	return c
+}
+
+// startJanitor launches the background goroutine that periodically sweeps
+// expired entries. It is only called when WithJanitor was supplied.
+func (l *CacheImpl[K, V]) startJanitor() {
+	l.stopCh = make(chan struct{})
+	ticker := time.NewTicker(l.janitorInterval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if l.locker != nil {
+					l.locker.Lock()
+				}
+				l.sweepExpired()
+				if l.locker != nil {
+					l.locker.Unlock()
+				}
+			case <-l.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// sweepExpired removes every currently expired entry from the cache.
+func (l *CacheImpl[K, V]) sweepExpired() {
+	now := time.Now()
+	for _, link := range l.keyToElement {
+		if link.Value.expired(now) {
+			l.removeExpired(link)
+		}
+	}
+}
+
+// Close stops the background janitor goroutine started by WithJanitor.
+// It is safe to call Close multiple times, and on a cache without a janitor.
+func (l *CacheImpl[K, V]) Close() {
+	l.closeOnce.Do(func() {
+		if l.stopCh != nil {
+			close(l.stopCh)
+		}
+	})
+}
+
+// Get promotes the key's item to its next frequency bucket and returns its
+// value. An expired entry is treated as a miss and evicted lazily. If the
+// key is missing and a Store was configured via WithStore, the store is
+// consulted and a hit is admitted into the cache with frequency 1.
+func (l *CacheImpl[K, V]) Get(key K) (V, error) {
+	if link, ok := l.keyToElement[key]; ok {
+		if link.Value.expired(time.Now()) {
+			l.removeExpired(link)
+			l.misses.Add(1)
+			return l.defaultValue, ErrKeyNotFound
+		}
+		l.promote(link)
+		l.hits.Add(1)
+		return link.Value.value, nil
+	}
+	if l.store != nil {
+		if value, found, err := l.store.Load(key); err == nil && found {
+			l.admit(key, value)
+			l.misses.Add(1)
+			return value, nil
+		}
+	}
+	l.misses.Add(1)
+	return l.defaultValue, ErrKeyNotFound
+}
+
+// admit inserts a value loaded from the backing Store as a fresh entry with
+// frequency 1, evicting if necessary to make room.
+func (l *CacheImpl[K, V]) admit(key K, value V) {
+	expiresAt := expiryFor(l.ttl)
+	if l.weigher != nil {
+		_ = l.putWeighted(key, value, expiresAt)
+		return
+	}
+	if l.size == l.capacity {
+		l.evictOne()
+	}
+	l.insertNode(&node[K, V]{key: key, value: value, freq: 1, expiresAt: expiresAt})
+}
+
+// evictOne evicts the least-frequently-used entry, flushing it to the
+// backing Store first if write-back mode is enabled.
+func (l *CacheImpl[K, V]) evictOne() {
+	if l.store != nil && l.writeMode == WriteBack {
+		if victim, ok := l.peekVictim(); ok {
+			_ = l.store.Save(victim.key, victim.value)
+		}
+	}
+	l.extractLatest()
+}
+
+// writeThrough saves key/value to the backing Store immediately, if one was
+// configured via WithStore in WriteThrough mode.
+func (l *CacheImpl[K, V]) writeThrough(key K, value V) {
+	if l.store != nil && l.writeMode == WriteThrough {
+		_ = l.store.Save(key, value)
+	}
+}
+
+// removeExpired drops an expired Element, found lazily by Get or
+// GetKeyFrequency or actively by sweepExpired, from its freqEntry and the
+// key index, flushing it to the backing Store first if
+// write-back mode is enabled, the same as capacity-driven eviction does.
+func (l *CacheImpl[K, V]) removeExpired(link *linkedlist.Element[*node[K, V]]) {
+	n := link.Value
+	if l.store != nil && l.writeMode == WriteBack {
+		_ = l.store.Save(n.key, n.value)
+	}
+	if l.weigher != nil {
+		l.currentBytes -= int64(l.weigher(n.key, n.value))
+	}
+	freqEl := n.freqEl
+	freqEl.Value.items.Remove(link)
+	delete(l.keyToElement, n.key)
+	l.size--
+	l.dropIfEmpty(freqEl)
+	l.evictions.Add(1)
+	if l.onEvict != nil {
+		l.onEvict(n.key, n.value, n.freq)
+	}
+}
+
+// Delete removes key from the cache, and from the backing Store if one was
+// configured via WithStore, regardless of whether key is currently cached.
+// It reports whether key was present in the cache.
+func (l *CacheImpl[K, V]) Delete(key K) bool {
+	link, ok := l.keyToElement[key]
+	if ok {
+		n := link.Value
+		freqEl := n.freqEl
+		freqEl.Value.items.Remove(link)
+		delete(l.keyToElement, key)
+		l.size--
+		l.dropIfEmpty(freqEl)
+		if l.weigher != nil {
+			l.currentBytes -= int64(l.weigher(n.key, n.value))
+		}
+	}
+	if l.store != nil {
+		_ = l.store.Delete(key)
+	}
+	return ok
+}
+
+// node: struct for storing cache elements.
+type node[K comparable, V any] struct {
+	key       K
+	value     V
+	freq      int
+	expiresAt time.Time                             // zero value means the entry never expires.
+	freqEl    *linkedlist.Element[*freqEntry[K, V]] // the freqEntry this item currently belongs to.
+}
+
+// expired reports whether the node's TTL has elapsed as of now.
+func (n *node[K, V]) expired(now time.Time) bool {
+	return !n.expiresAt.IsZero() && now.After(n.expiresAt)
+}
+
+// expiryFor turns a TTL into an absolute deadline, or the zero Time if the
+// entry should never expire.
+func expiryFor(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(ttl)
+}
+
+// pushFront inserts e as the new MRU (most recently used) element of l.
+func pushFront[E any](l linkedlist.ListInterface[E], e *linkedlist.Element[E]) {
+	if l.Len() == 0 {
+		l.Add(e)
+		return
+	}
+	l.AddBefore(e, l.Front())
+}
+
+// insertNode inserts a brand new node at frequency 1, creating that
+// freqEntry if this is the first item at the lowest frequency.
+func (l *CacheImpl[K, V]) insertNode(n *node[K, V]) {
+	freqEl, ok := l.freqIndex[1]
+	if !ok {
+		freqEl = linkedlist.NewElement(&freqEntry[K, V]{freq: 1, items: linkedlist.NewList[*node[K, V]]()})
+		// Frequency 1 is always the current minimum, so its freqEntry
+		// belongs at the back of the descending-frequency freqList.
+		l.freqList.Add(freqEl)
+		l.freqIndex[1] = freqEl
+	}
+	link := linkedlist.NewElement(n)
+	pushFront(freqEl.Value.items, link)
+	n.freqEl = freqEl
+	l.keyToElement[n.key] = link
+	l.size++
+	l.insertions.Add(1)
+}
+
+// promote moves an item's node from its current freqEntry to the next
+// frequency's freqEntry, creating it if absent, and drops the old freqEntry
+// if it is left empty. The promoted item becomes the new MRU of its bucket.
+func (l *CacheImpl[K, V]) promote(link *linkedlist.Element[*node[K, V]]) {
+	n := link.Value
+	oldFreqEl := n.freqEl
+	oldFreqEl.Value.items.Remove(link)
+
+	n.freq++
+	newFreqEl, ok := l.freqIndex[n.freq]
+	if !ok {
+		newFreqEl = linkedlist.NewElement(&freqEntry[K, V]{freq: n.freq, items: linkedlist.NewList[*node[K, V]]()})
+		// No freqEntry at n.freq exists yet, so the nearest one toward the
+		// front of freqList (if any) is strictly higher: inserting right
+		// before oldFreqEl keeps the list in descending order.
+		l.freqList.AddBefore(newFreqEl, oldFreqEl)
+		l.freqIndex[n.freq] = newFreqEl
+	}
+	pushFront(newFreqEl.Value.items, link)
+	n.freqEl = newFreqEl
+
+	l.dropIfEmpty(oldFreqEl)
+}
+
+// dropIfEmpty removes a freqEntry from freqList and freqIndex once its
+// items list has no entries left.
+func (l *CacheImpl[K, V]) dropIfEmpty(freqEl *linkedlist.Element[*freqEntry[K, V]]) {
+	if freqEl.Value.items.Len() == 0 {
+		l.freqList.Remove(freqEl)
+		delete(l.freqIndex, freqEl.Value.freq)
+	}
+}
+
+// peekVictim returns the entry that extractLatest would evict next, without
+// removing it, so callers can flush it to a backing Store beforehand.
+func (l *CacheImpl[K, V]) peekVictim() (*node[K, V], bool) {
+	freqEl := l.freqList.Back()
+	if freqEl == nil {
+		return nil, false
+	}
+	back := freqEl.Value.items.Back()
+	if back == nil {
+		return nil, false
+	}
+	return back.Value, true
+}
+
+// extractLatest extracts the least recently used Element of all least
+// frequently used elements, dropping its freqEntry if it is left empty.
+func (l *CacheImpl[K, V]) extractLatest() {
+	freqEl := l.freqList.Back()
+	if freqEl == nil {
+		return
+	}
+	del := freqEl.Value.items.PopBack()
+	if del == nil {
+		return
+	}
+	l.evictions.Add(1)
+	if l.onEvict != nil {
+		l.onEvict(del.Value.key, del.Value.value, del.Value.freq)
+	}
+	if l.weigher != nil {
+		l.currentBytes -= int64(l.weigher(del.Value.key, del.Value.value))
+	}
+	delete(l.keyToElement, del.Value.key)
+	l.size--
+	l.dropIfEmpty(freqEl)
+}
+
+// Put puts new node to cache, using the cache's default TTL (see WithTTL)
+// and, if a Store was configured via WithStore in WriteThrough mode, saving
+// it immediately. On a byte-weighted cache (see NewWithWeight), Put cannot
+// report ErrSizeExceedsCapacity, because the Cache interface's Put has no
+// error result; an entry whose own weight exceeds maxBytes is silently
+// rejected (the cache is left unchanged) instead. Use PutWeighted directly
+// to observe that case.
+func (l *CacheImpl[K, V]) Put(key K, value V) {
+	if l.weigher != nil {
+		_ = l.putWeighted(key, value, expiryFor(l.ttl))
+	} else {
+		l.put(key, value, expiryFor(l.ttl))
+	}
+	l.writeThrough(key, value)
+}
+
+// PutWithTTL behaves like Put but overrides the cache's default TTL for this
+// entry only. A ttl <= 0 means the entry never expires.
+func (l *CacheImpl[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	if l.weigher != nil {
+		_ = l.putWeighted(key, value, expiryFor(ttl))
+	} else {
+		l.put(key, value, expiryFor(ttl))
+	}
+	l.writeThrough(key, value)
+}
+
+// PutWeighted behaves like Put on a byte-weighted cache created via
+// NewWithWeight: least-frequently-used entries are evicted, repeatedly if
+// necessary, until weigher(key, value) fits within maxBytes. It returns
+// ErrSizeExceedsCapacity if value alone is larger than maxBytes, in which
+// case the cache is left unchanged.
+func (l *CacheImpl[K, V]) PutWeighted(key K, value V) error {
+	if err := l.putWeighted(key, value, expiryFor(l.ttl)); err != nil {
+		return err
+	}
+	l.writeThrough(key, value)
+	return nil
+}
+
+// putWeighted is the byte-weighted eviction path used when weigher is set.
+func (l *CacheImpl[K, V]) putWeighted(key K, value V, expiresAt time.Time) error {
+	weight := int64(l.weigher(key, value))
+	if weight > l.maxBytes {
+		return ErrSizeExceedsCapacity
+	}
+
+	if link, ok := l.keyToElement[key]; ok {
+		l.currentBytes -= int64(l.weigher(key, link.Value.value))
+		link.Value.value = value
+		link.Value.expiresAt = expiresAt
+		l.promote(link)
+		l.currentBytes += weight
+		// Growing an existing entry can push currentBytes over maxBytes just
+		// as much as inserting a new one can; evict other entries until it
+		// fits again. l.size > 1 alone doesn't prove there's another entry
+		// to evict: if the promote above left this entry alone in the new
+		// lowest-frequency bucket, peekVictim would pick this very entry
+		// next. Set that bucket aside for one eviction instead, so the LRU
+		// entry of the next bucket up goes rather than the key the caller
+		// just wrote; weight <= maxBytes above guarantees it fits once it's
+		// the last entry left.
+		for l.size > 1 && l.currentBytes > l.maxBytes {
+			victim, ok := l.peekVictim()
+			if ok && victim == link.Value {
+				own := link.Value.freqEl
+				l.freqList.Remove(own)
+				l.evictOne()
+				l.freqList.Add(own)
+				continue
+			}
+			l.evictOne()
+		}
+		return nil
+	}
+
+	for l.size > 0 && l.currentBytes+weight > l.maxBytes {
+		l.evictOne()
+	}
+
+	l.insertNode(&node[K, V]{key: key, value: value, freq: 1, expiresAt: expiresAt})
+	l.currentBytes += weight
+	return nil
+}
+
+// Bytes returns the cache's current byte usage for a byte-weighted cache
+// created via NewWithWeight. It is always zero for item-count caches.
+func (l *CacheImpl[K, V]) Bytes() int64 {
+	return l.currentBytes
+}
+
+// put inserts or updates key with the given absolute expiration deadline.
+func (l *CacheImpl[K, V]) put(key K, value V, expiresAt time.Time) {
+	if link, ok := l.keyToElement[key]; ok {
+		// Case when cache contains Element with such key. Put updates its
+		// value and promotes it to the next frequency bucket.
+		link.Value.value = value
+		link.Value.expiresAt = expiresAt
+		l.promote(link)
+		return
+	}
+
+	if l.size == l.capacity {
+		// Case when adding occurs to a full cache. Extract latest Element and then add new.
+		l.evictOne()
+	}
+
+	l.insertNode(&node[K, V]{key: key, value: value, freq: 1, expiresAt: expiresAt})
+}
+
+// All returns an iterator in descending order of frequency (freqList runs
+// highest to lowest), and within a frequency, from most to least recently
+// used. Expired entries are skipped but not evicted; they are reclaimed
+// lazily by Get or actively by the janitor.
+func (l *CacheImpl[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		now := time.Now()
+		for entry := range l.freqList.Iterator() {
+			for n := range entry.items.Iterator() {
+				if n.expired(now) {
+					continue
+				}
+				if !yield(n.key, n.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Size returns the cache size.
+func (l *CacheImpl[K, V]) Size() int {
+	return l.size
+}
+
+// Capacity returns the cache capacity: the maximum number of entries, or
+// for a byte-weighted cache created via NewWithWeight, maxBytes instead
+// (see Bytes).
+func (l *CacheImpl[K, V]) Capacity() int {
+	return l.capacity
+}
+
+// GetKeyFrequency returns the frequency of given key Element if such key exists.
+// An expired entry is treated as not found and evicted lazily.
+func (l *CacheImpl[K, V]) GetKeyFrequency(key K) (int, error) {
+	if link, ok := l.keyToElement[key]; ok {
+		if link.Value.expired(time.Now()) {
+			l.removeExpired(link)
+			return 0, ErrKeyNotFound
+		}
+		return link.Value.freq, nil
+	}
+	return 0, ErrKeyNotFound
+}
+
+// Stats returns a point-in-time snapshot of the cache's hit, miss, eviction,
+// and insertion counters. The counters are updated with atomics and may be
+// read concurrently with cache operations, though CacheImpl's own methods
+// are not otherwise safe for concurrent use (see NewSync).
+func (l *CacheImpl[K, V]) Stats() Stats {
+	return Stats{
+		Hits:       l.hits.Load(),
+		Misses:     l.misses.Load(),
+		Evictions:  l.evictions.Load(),
+		Insertions: l.insertions.Load(),
+	}
+}
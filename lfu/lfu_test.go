@@ -0,0 +1,84 @@
+package lfu
+
+import "testing"
+
+// TestLFUEvictsLeastFrequentlyUsed covers the core freq-bucket bookkeeping:
+// the entry with the lowest frequency is evicted first, even when it is the
+// most recently inserted one. Ties are covered by
+// TestLFUTiesBreakByLeastRecentlyUsed.
+func TestLFUEvictsLeastFrequentlyUsed(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a") // a now has frequency 2, b stays at 1
+
+	c.Put("c", 3) // evicts b, the sole frequency-1 entry
+
+	if _, err := c.Get("b"); err != ErrKeyNotFound {
+		t.Fatalf("Get(b) err = %v, want ErrKeyNotFound", err)
+	}
+	if v, err := c.Get("a"); err != nil || v != 1 {
+		t.Errorf("Get(a) = (%d, %v), want (1, nil)", v, err)
+	}
+	if v, err := c.Get("c"); err != nil || v != 3 {
+		t.Errorf("Get(c) = (%d, %v), want (3, nil)", v, err)
+	}
+}
+
+// TestLFUTiesBreakByLeastRecentlyUsed covers eviction among entries that
+// share the same frequency bucket.
+func TestLFUTiesBreakByLeastRecentlyUsed(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2) // both at frequency 1; b is more recent than a
+
+	c.Put("c", 3) // evicts a, the LRU entry within frequency 1
+
+	if _, err := c.Get("a"); err != ErrKeyNotFound {
+		t.Fatalf("Get(a) err = %v, want ErrKeyNotFound", err)
+	}
+	if _, err := c.Get("b"); err != nil {
+		t.Errorf("Get(b) err = %v, want nil", err)
+	}
+}
+
+// TestPromoteDropsEmptyFreqEntry covers that a frequency bucket left empty
+// by a promotion is removed from the freqList/freqIndex, not just the item
+// removed from its items list.
+func TestPromoteDropsEmptyFreqEntry(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+	c.Get("a") // promotes a from freq 1 to freq 2, emptying freq 1's bucket
+
+	if _, ok := c.freqIndex[1]; ok {
+		t.Errorf("freqIndex[1] still present after its only entry was promoted away")
+	}
+	if freq, err := c.GetKeyFrequency("a"); err != nil || freq != 2 {
+		t.Errorf("GetKeyFrequency(a) = (%d, %v), want (2, nil)", freq, err)
+	}
+}
+
+// TestAllDescendingFrequencyOrder covers All's documented ordering: highest
+// frequency first, and most-recently-used first within a tied frequency.
+func TestAllDescendingFrequencyOrder(t *testing.T) {
+	c := New[string, int](3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("c") // c: freq 2
+	c.Get("a") // a: freq 2, more recent than c within freq 2
+
+	var order []string
+	for k := range c.All() {
+		order = append(order, k)
+	}
+	want := []string{"a", "c", "b"}
+	if len(order) != len(want) {
+		t.Fatalf("All() = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("All()[%d] = %q, want %q (order = %v)", i, order[i], want[i], order)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package lfu
+
+import (
+	"fmt"
+	"hash/fnv"
+	"hash/maphash"
+	"iter"
+	"sort"
+)
+
+// shardSeed is shared by every Sharded instance: maphash only needs to be
+// consistent within a single process, not across restarts or instances.
+var shardSeed = maphash.MakeSeed()
+
+// Sharded hash-partitions keys across N independent, mutex-protected
+// sub-caches, so unrelated keys rarely contend on the same lock. Each shard
+// tracks its own frequencies and capacity independently: this trades exact
+// global LFU ordering for throughput under concurrent access.
+type Sharded[K comparable, V any] struct {
+	shards []*Sync[K, V]
+}
+
+// NewSharded creates a sharded cache with the given total capacity split
+// evenly across shards. Each shard gets at least a capacity of 1.
+func NewSharded[K comparable, V any](capacity, shards int) *Sharded[K, V] {
+	if shards <= 0 {
+		panic("Sharded cache requires a positive number of shards.")
+	}
+	perShard := capacity / shards
+	if perShard <= 0 {
+		perShard = 1
+	}
+	s := &Sharded[K, V]{shards: make([]*Sync[K, V], shards)}
+	for i := range s.shards {
+		s.shards[i] = NewSync[K, V](perShard)
+	}
+	return s
+}
+
+// shardFor returns the sub-cache a key is hash-partitioned to.
+func (s *Sharded[K, V]) shardFor(key K) *Sync[K, V] {
+	return s.shards[hashKey(key)%uint64(len(s.shards))]
+}
+
+// hashKey hashes the common comparable key kinds directly, avoiding the
+// reflection and allocation that a fmt.Sprintf-based hash would add to
+// every Get/Put on Sharded's hot path. Uncommon key types fall back to a
+// slower reflective hash, which is still correct, just not the fast path.
+func hashKey[K comparable](key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return maphash.String(shardSeed, k)
+	case []byte:
+		return maphash.Bytes(shardSeed, k)
+	case int:
+		return avalanche(uint64(k))
+	case int8:
+		return avalanche(uint64(k))
+	case int16:
+		return avalanche(uint64(k))
+	case int32:
+		return avalanche(uint64(k))
+	case int64:
+		return avalanche(uint64(k))
+	case uint:
+		return avalanche(uint64(k))
+	case uint8:
+		return avalanche(uint64(k))
+	case uint16:
+		return avalanche(uint64(k))
+	case uint32:
+		return avalanche(uint64(k))
+	case uint64:
+		return avalanche(k)
+	case uintptr:
+		return avalanche(uint64(k))
+	default:
+		h := fnv.New64a()
+		fmt.Fprintf(h, "%v", k)
+		return h.Sum64()
+	}
+}
+
+// avalanche is the splitmix64 finalizer: cheap, and spreads sequential
+// integers (common for cache keys, e.g. in benchmarks) across shards
+// instead of clustering them by low bits.
+func avalanche(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+// Get delegates to the shard that owns key.
+func (s *Sharded[K, V]) Get(key K) (V, error) {
+	return s.shardFor(key).Get(key)
+}
+
+// Put delegates to the shard that owns key.
+func (s *Sharded[K, V]) Put(key K, value V) {
+	s.shardFor(key).Put(key, value)
+}
+
+// Size returns the combined size of all shards.
+func (s *Sharded[K, V]) Size() int {
+	total := 0
+	for _, shard := range s.shards {
+		total += shard.Size()
+	}
+	return total
+}
+
+// Capacity returns the combined capacity of all shards.
+func (s *Sharded[K, V]) Capacity() int {
+	total := 0
+	for _, shard := range s.shards {
+		total += shard.Capacity()
+	}
+	return total
+}
+
+// GetKeyFrequency delegates to the shard that owns key.
+func (s *Sharded[K, V]) GetKeyFrequency(key K) (int, error) {
+	return s.shardFor(key).GetKeyFrequency(key)
+}
+
+// Delete delegates to the shard that owns key.
+func (s *Sharded[K, V]) Delete(key K) bool {
+	return s.shardFor(key).Delete(key)
+}
+
+// Stats returns the sum of every shard's Stats.
+func (s *Sharded[K, V]) Stats() Stats {
+	var total Stats
+	for _, shard := range s.shards {
+		shardStats := shard.Stats()
+		total.Hits += shardStats.Hits
+		total.Misses += shardStats.Misses
+		total.Evictions += shardStats.Evictions
+		total.Insertions += shardStats.Insertions
+	}
+	return total
+}
+
+// Close stops every shard's janitor goroutine, if any. It is a no-op on
+// shards without one, and safe to call multiple times.
+func (s *Sharded[K, V]) Close() {
+	for _, shard := range s.shards {
+		shard.Close()
+	}
+}
+
+// All merges every shard's iterator in descending-frequency order on a
+// best-effort basis: ordering within a shard is exact, but since each shard
+// tracks frequency independently, keys from different shards are merged by
+// a single snapshot sort rather than a true global LFU order.
+func (s *Sharded[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		type entry struct {
+			key  K
+			val  V
+			freq int
+		}
+		var merged []entry
+		for _, shard := range s.shards {
+			for k, fv := range shard.AllWithFrequency() {
+				merged = append(merged, entry{key: k, val: fv.Value, freq: fv.Freq})
+			}
+		}
+		sort.SliceStable(merged, func(i, j int) bool {
+			return merged[i].freq > merged[j].freq
+		})
+		for _, e := range merged {
+			if !yield(e.key, e.val) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package lfu
+
+import (
+	"strconv"
+	"testing"
+)
+
+// BenchmarkSharded_Put measures Put throughput under concurrent load for a
+// range of shard counts, to show contention dropping as shards increase.
+func BenchmarkSharded_Put(b *testing.B) {
+	for _, shards := range []int{1, 2, 4, 8, 16} {
+		b.Run(strconv.Itoa(shards), func(b *testing.B) {
+			c := NewSharded[int, int](1000, shards)
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					c.Put(i, i)
+					i++
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkSync_Put is the single-mutex baseline BenchmarkSharded_Put is
+// compared against.
+func BenchmarkSync_Put(b *testing.B) {
+	c := NewSync[int, int](1000)
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			c.Put(i, i)
+			i++
+		}
+	})
+}
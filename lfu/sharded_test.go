@@ -0,0 +1,36 @@
+package lfu
+
+import (
+	"strconv"
+	"testing"
+)
+
+// TestShardedAllMergesShards exercises All across multiple shards, guarding
+// against the AllWithFrequency deadlock regression (a re-lock of the same
+// shard's mutex from inside its own read-locked iteration).
+func TestShardedAllMergesShards(t *testing.T) {
+	// Capacity is sized well above 8/shards so all 8 keys are retained
+	// regardless of how the hash happens to partition them across shards;
+	// the test cares about All() merging correctly, not about distribution.
+	c := NewSharded[int, string](80, 4)
+	for i := 0; i < 8; i++ {
+		c.Put(i, strconv.Itoa(i))
+	}
+	// Access one key twice more so it sits at a higher frequency within its
+	// own shard.
+	c.Get(0)
+	c.Get(0)
+
+	seen := make(map[int]string)
+	for k, v := range c.All() {
+		seen[k] = v
+	}
+	if len(seen) != 8 {
+		t.Fatalf("All yielded %d entries, want 8", len(seen))
+	}
+	for i := 0; i < 8; i++ {
+		if seen[i] != strconv.Itoa(i) {
+			t.Errorf("All()[%d] = %q, want %q", i, seen[i], strconv.Itoa(i))
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package lfu
+
+// Store is a pluggable backing store that lets a CacheImpl act as a hot
+// tier over arbitrary secondary storage (a file, Redis, BoltDB, ...). See
+// WithStore.
+type Store[K comparable, V any] interface {
+	// Load fetches a value for key from the backing store. The bool result
+	// reports whether the key was found.
+	Load(key K) (V, bool, error)
+
+	// Save persists value for key to the backing store.
+	Save(key K, value V) error
+
+	// Delete removes key from the backing store.
+	Delete(key K) error
+}
+
+// WriteMode controls when a CacheImpl configured with WithStore persists
+// writes to its backing Store.
+type WriteMode int
+
+const (
+	// WriteBack saves an entry to the Store only when it is evicted from
+	// the cache, trading durability for fewer writes.
+	WriteBack WriteMode = iota
+
+	// WriteThrough saves an entry to the Store synchronously on every Put.
+	WriteThrough
+)
+
+// WithStore delegates cache misses and evictions to a backing Store,
+// turning the in-memory LFU cache into a hot tier over secondary storage.
+// On a Get miss, the Store is consulted and a hit is admitted into the
+// cache with frequency 1. On Put, mode controls whether the entry is saved
+// immediately (WriteThrough) or only once evicted (WriteBack).
+func WithStore[K comparable, V any](store Store[K, V], mode WriteMode) Option[K, V] {
+	return func(c *CacheImpl[K, V]) {
+		c.store = store
+		c.writeMode = mode
+	}
+}
@@ -0,0 +1,91 @@
+package lfu
+
+import (
+	"testing"
+	"time"
+)
+
+// memStore is a minimal in-memory Store used to observe what CacheImpl
+// saves to its backing store.
+type memStore[K comparable, V any] struct {
+	saved map[K]V
+}
+
+func newMemStore[K comparable, V any]() *memStore[K, V] {
+	return &memStore[K, V]{saved: make(map[K]V)}
+}
+
+func (s *memStore[K, V]) Load(key K) (V, bool, error) {
+	v, ok := s.saved[key]
+	return v, ok, nil
+}
+
+func (s *memStore[K, V]) Save(key K, value V) error {
+	s.saved[key] = value
+	return nil
+}
+
+func (s *memStore[K, V]) Delete(key K) error {
+	delete(s.saved, key)
+	return nil
+}
+
+// TestTTLExpiryWriteBack covers the combination of TTL expiration with a
+// WriteBack Store: an entry that expires before it would otherwise be
+// capacity-evicted previously skipped the write-back entirely, losing data
+// that was never flushed anywhere.
+func TestTTLExpiryWriteBack(t *testing.T) {
+	store := newMemStore[string, int]()
+	c := New[string, int](2, WithTTL[string, int](time.Millisecond), WithStore[string, int](store, WriteBack))
+
+	c.Put("a", 1)
+	time.Sleep(5 * time.Millisecond)
+
+	if _, err := c.Get("a"); err != ErrKeyNotFound {
+		t.Fatalf("Get(a) err = %v, want ErrKeyNotFound", err)
+	}
+	if got, ok := store.saved["a"]; !ok || got != 1 {
+		t.Errorf("store.saved[a] = (%d, %v), want (1, true)", got, ok)
+	}
+}
+
+// TestJanitorSweepWriteBack covers the active janitor path hitting the same
+// write-back gap as the lazy Get path.
+func TestJanitorSweepWriteBack(t *testing.T) {
+	store := newMemStore[string, int]()
+	c := New[string, int](2, WithTTL[string, int](time.Millisecond), WithStore[string, int](store, WriteBack))
+
+	c.Put("a", 1)
+	c.sweepExpired() // not yet expired
+	if _, ok := store.saved["a"]; ok {
+		t.Fatalf("store.saved[a] present before expiry")
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	c.sweepExpired()
+	if got, ok := store.saved["a"]; !ok || got != 1 {
+		t.Errorf("store.saved[a] = (%d, %v), want (1, true)", got, ok)
+	}
+}
+
+// TestDeleteRemovesFromCacheAndStore covers that Delete both drops the entry
+// from the cache's own bookkeeping and reaches the backing Store, previously
+// unreachable dead interface surface.
+func TestDeleteRemovesFromCacheAndStore(t *testing.T) {
+	store := newMemStore[string, int]()
+	c := New[string, int](2, WithStore[string, int](store, WriteThrough))
+
+	c.Put("a", 1)
+	if !c.Delete("a") {
+		t.Fatalf("Delete(a) = false, want true")
+	}
+	if _, err := c.Get("a"); err != ErrKeyNotFound {
+		t.Errorf("Get(a) err = %v, want ErrKeyNotFound", err)
+	}
+	if _, ok := store.saved["a"]; ok {
+		t.Errorf("store.saved[a] present after Delete")
+	}
+	if c.Delete("missing") {
+		t.Errorf("Delete(missing) = true, want false")
+	}
+}
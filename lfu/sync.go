@@ -0,0 +1,162 @@
+package lfu
+
+import (
+	"iter"
+	"sync"
+)
+
+// Sync wraps a Cache[K, V] with a sync.RWMutex to make it safe for
+// concurrent use. Get, Put and GetKeyFrequency all mutate cache state
+// (frequency bookkeeping, and lazy TTL eviction), so they take the write
+// lock; Size, Capacity and All only need the read lock.
+//
+// If the wrapped cache is constructed with WithJanitor, NewSync makes the
+// janitor goroutine acquire this same mutex around every sweep, so it is
+// safe to combine with concurrent access.
+type Sync[K comparable, V any] struct {
+	mu    sync.RWMutex
+	cache Cache[K, V]
+}
+
+// NewSync wraps a new LFU cache of the given capacity and options with a
+// mutex. Passing WithJanitor makes the janitor goroutine acquire this same
+// mutex around every sweep, unlike a janitor started on a bare New cache.
+func NewSync[K comparable, V any](capacity int, opts ...Option[K, V]) *Sync[K, V] {
+	s := &Sync[K, V]{}
+	s.cache = New[K, V](capacity, append(opts, withLocker[K, V](&s.mu))...)
+	return s
+}
+
+// Get locks the cache and delegates to the wrapped Cache.
+func (s *Sync[K, V]) Get(key K) (V, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Get(key)
+}
+
+// Put locks the cache and delegates to the wrapped Cache.
+func (s *Sync[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.Put(key, value)
+}
+
+// Delete locks the cache and delegates to the wrapped Cache.
+func (s *Sync[K, V]) Delete(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d, ok := s.cache.(deleter[K]); ok {
+		return d.Delete(key)
+	}
+	return false
+}
+
+// All returns an iterator that holds the read lock for the duration of the
+// iteration, so the snapshot it walks cannot change concurrently.
+func (s *Sync[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		for k, v := range s.cache.All() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// FreqValue pairs a cached value with its frequency, as returned by
+// AllWithFrequency.
+type FreqValue[V any] struct {
+	Value V
+	Freq  int
+}
+
+// AllWithFrequency returns an iterator like All, but also yields each
+// entry's frequency. It exists for callers such as Sharded.All that need
+// both a value and its frequency from a single locked pass: calling the
+// locking GetKeyFrequency per key while already holding All's read lock
+// would deadlock against that same RWMutex, since it is not reentrant.
+//
+// It takes the write lock, not the read lock: GetKeyFrequency mutates
+// cache state (it lazily evicts an expired entry via removeExpired), so
+// multiple concurrent AllWithFrequency calls under a mere read lock could
+// both trigger removeExpired unsynchronized.
+func (s *Sync[K, V]) AllWithFrequency() iter.Seq2[K, FreqValue[V]] {
+	return func(yield func(K, FreqValue[V]) bool) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for k, v := range s.cache.All() {
+			freq, err := s.cache.GetKeyFrequency(k)
+			if err != nil {
+				continue
+			}
+			if !yield(k, FreqValue[V]{Value: v, Freq: freq}) {
+				return
+			}
+		}
+	}
+}
+
+// Size locks the cache and delegates to the wrapped Cache.
+func (s *Sync[K, V]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cache.Size()
+}
+
+// Capacity locks the cache and delegates to the wrapped Cache.
+func (s *Sync[K, V]) Capacity() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cache.Capacity()
+}
+
+// GetKeyFrequency locks the cache and delegates to the wrapped Cache.
+func (s *Sync[K, V]) GetKeyFrequency(key K) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.GetKeyFrequency(key)
+}
+
+// statsProvider is implemented by caches that track Stats. Cache[K, V]
+// itself doesn't declare Stats, since not every implementation (e.g. arc.Cache)
+// tracks these counters.
+type statsProvider interface {
+	Stats() Stats
+}
+
+// Stats returns the wrapped cache's Stats, or the zero Stats if it does not
+// track them. NewSync always wraps a *CacheImpl, which does.
+func (s *Sync[K, V]) Stats() Stats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if sp, ok := s.cache.(statsProvider); ok {
+		return sp.Stats()
+	}
+	return Stats{}
+}
+
+// deleter is implemented by caches that support removing a key outright.
+// Cache[K, V] itself doesn't declare Delete, since arc.Cache has no
+// standalone removal (only capacity- and ghost-list-driven eviction).
+type deleter[K comparable] interface {
+	Delete(key K) bool
+}
+
+// closer is implemented by caches with a background goroutine to stop, such
+// as the janitor started by WithJanitor.
+type closer interface {
+	Close()
+}
+
+// Close stops the wrapped cache's janitor goroutine, if WithJanitor was
+// passed to NewSync. It is a no-op otherwise, and safe to call multiple
+// times.
+func (s *Sync[K, V]) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok := s.cache.(closer); ok {
+		c.Close()
+	}
+}
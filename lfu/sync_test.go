@@ -0,0 +1,41 @@
+package lfu
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestConcurrentAllWithFrequencyUnderTTL drives concurrent
+// AllWithFrequency/GetKeyFrequency calls against a TTL'd cache, where
+// GetKeyFrequency's lazy removeExpired mutates freqList/freqIndex/
+// keyToElement. Run with -race: AllWithFrequency previously only took the
+// read lock around this mutating call, so two goroutines could both reach
+// removeExpired unsynchronized.
+func TestConcurrentAllWithFrequencyUnderTTL(t *testing.T) {
+	c := NewSync[int, int](64, WithTTL[int, int](time.Millisecond))
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					c.Put(g, g)
+					for range c.AllWithFrequency() {
+					}
+					c.GetKeyFrequency(g)
+				}
+			}
+		}(g)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(stop)
+	wg.Wait()
+}
@@ -0,0 +1,102 @@
+package lfu
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestPutWeightedRejectsOversizedEntry covers the most basic contract of
+// the byte-weighted cache: a single entry heavier than maxBytes can never
+// fit no matter what else is evicted, so it must be rejected outright
+// rather than evicting everything else and still not fitting.
+func TestPutWeightedRejectsOversizedEntry(t *testing.T) {
+	weigher := func(_ int, v string) int { return len(v) }
+	c := NewWithWeight[int, string](10, weigher)
+
+	if err := c.PutWeighted(1, "aaaaaaaaaaaaaaa"); !errors.Is(err, ErrSizeExceedsCapacity) {
+		t.Fatalf("PutWeighted(oversized) = %v, want ErrSizeExceedsCapacity", err)
+	}
+	if got := c.Bytes(); got != 0 {
+		t.Errorf("Bytes() = %d, want 0: cache must be left unchanged", got)
+	}
+	if _, err := c.Get(1); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Get(1) = %v, want ErrKeyNotFound: oversized entry must not be admitted", err)
+	}
+}
+
+// TestPutWeightedUpdateEnforcesBudget covers growing an existing key's
+// weight: the eviction loop previously only ran on the new-key insert path,
+// so repeatedly growing one entry could push currentBytes arbitrarily over
+// maxBytes with no eviction ever running.
+func TestPutWeightedUpdateEnforcesBudget(t *testing.T) {
+	weigher := func(_ int, v string) int { return len(v) }
+	c := NewWithWeight[int, string](10, weigher)
+
+	if err := c.PutWeighted(1, "aaa"); err != nil {
+		t.Fatalf("PutWeighted(1) = %v, want nil", err)
+	}
+	if err := c.PutWeighted(2, "bbb"); err != nil {
+		t.Fatalf("PutWeighted(2) = %v, want nil", err)
+	}
+	if got := c.Bytes(); got != 6 {
+		t.Fatalf("Bytes() = %d, want 6", got)
+	}
+
+	// Growing key 1 to 8 bytes would push the total to 11, over the 10-byte
+	// budget, unless the update path evicts key 2 first.
+	if err := c.PutWeighted(1, "aaaaaaaa"); err != nil {
+		t.Fatalf("PutWeighted(1, grown) = %v, want nil", err)
+	}
+	if got := c.Bytes(); got > 10 {
+		t.Errorf("Bytes() = %d, want <= 10", got)
+	}
+	if _, err := c.Get(1); err != nil {
+		t.Errorf("Get(1) = %v, want the grown entry still present", err)
+	}
+}
+
+// TestPutWeightedUpdateDoesNotEvictItself covers growing an existing key
+// into the entry promote() leaves alone in the new lowest-frequency
+// bucket: the eviction loop used to run after promote() and could pick
+// this very entry as its own victim, silently dropping the value the
+// caller just wrote (PutWeighted still returned nil and Bytes() still
+// looked within budget).
+func TestPutWeightedUpdateDoesNotEvictItself(t *testing.T) {
+	weigher := func(_ int, v string) int { return len(v) }
+	c := NewWithWeight[int, string](10, weigher)
+
+	if err := c.PutWeighted(2, "bb"); err != nil {
+		t.Fatalf("PutWeighted(2) = %v, want nil", err)
+	}
+	// Raise key 2's frequency above where key 1's grown frequency will
+	// land, so key 1 ends up alone in the new lowest-frequency bucket.
+	if _, err := c.Get(2); err != nil {
+		t.Fatalf("Get(2) = %v, want nil", err)
+	}
+	if _, err := c.Get(2); err != nil {
+		t.Fatalf("Get(2) = %v, want nil", err)
+	}
+	if err := c.PutWeighted(1, "a"); err != nil {
+		t.Fatalf("PutWeighted(1) = %v, want nil", err)
+	}
+
+	// Growing key 1 to 9 bytes promotes it to frequency 2, which, with key
+	// 2 sitting at frequency 3, becomes the new lowest-frequency bucket —
+	// and key 1 is its sole occupant.
+	if err := c.PutWeighted(1, "aaaaaaaaa"); err != nil {
+		t.Fatalf("PutWeighted(1, grown) = %v, want nil", err)
+	}
+	if got, err := c.Get(1); err != nil || got != "aaaaaaaaa" {
+		t.Errorf("Get(1) = (%q, %v), want (\"aaaaaaaaa\", nil): the entry just written must never evict itself", got, err)
+	}
+}
+
+// TestCapacityReflectsMaxBytes covers a byte-weighted cache's Capacity,
+// which previously always reported DefaultCapacity (5) regardless of
+// maxBytes.
+func TestCapacityReflectsMaxBytes(t *testing.T) {
+	c := NewWithWeight[int, string](4096, func(_ int, v string) int { return len(v) })
+	if got := c.Capacity(); got != 4096 {
+		t.Errorf("Capacity() = %d, want 4096", got)
+	}
+}
@@ -0,0 +1,71 @@
+// Package promlfu adapts an lfu cache's Stats into a prometheus.Collector,
+// kept separate from lfucache/lfu so the core cache package does not depend
+// on Prometheus.
+package promlfu
+
+import (
+	"lfucache/lfu"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Cache is the subset of lfucache/lfu's cache types that NewCollector needs.
+// *lfu.CacheImpl[K, V], *lfu.Sync[K, V] and *lfu.Sharded[K, V] all satisfy
+// it, so a collector can be built around whichever concurrency wrapper a
+// caller is already using, rather than only the unwrapped cache.
+type Cache interface {
+	Stats() lfu.Stats
+	Size() int
+	Capacity() int
+}
+
+// Collector exposes a Cache's hit/miss/eviction/insertion counters, plus its
+// current size and capacity, as Prometheus metrics.
+type Collector struct {
+	cache Cache
+
+	hits       *prometheus.Desc
+	misses     *prometheus.Desc
+	evictions  *prometheus.Desc
+	insertions *prometheus.Desc
+	size       *prometheus.Desc
+	capacity   *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*Collector)(nil)
+
+// NewCollector wraps cache so its Stats are exposed as Prometheus metrics
+// named "<namespace>_hits_total", "<namespace>_misses_total", and so on.
+// Register the result with a prometheus.Registerer.
+func NewCollector(cache Cache, namespace string) *Collector {
+	return &Collector{
+		cache:      cache,
+		hits:       prometheus.NewDesc(namespace+"_hits_total", "Total number of cache hits.", nil, nil),
+		misses:     prometheus.NewDesc(namespace+"_misses_total", "Total number of cache misses.", nil, nil),
+		evictions:  prometheus.NewDesc(namespace+"_evictions_total", "Total number of entries evicted.", nil, nil),
+		insertions: prometheus.NewDesc(namespace+"_insertions_total", "Total number of entries inserted.", nil, nil),
+		size:       prometheus.NewDesc(namespace+"_size", "Current number of entries in the cache.", nil, nil),
+		capacity:   prometheus.NewDesc(namespace+"_capacity", "Configured cache capacity.", nil, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.evictions
+	ch <- c.insertions
+	ch <- c.size
+	ch <- c.capacity
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.cache.Stats()
+	ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(c.evictions, prometheus.CounterValue, float64(stats.Evictions))
+	ch <- prometheus.MustNewConstMetric(c.insertions, prometheus.CounterValue, float64(stats.Insertions))
+	ch <- prometheus.MustNewConstMetric(c.size, prometheus.GaugeValue, float64(c.cache.Size()))
+	ch <- prometheus.MustNewConstMetric(c.capacity, prometheus.GaugeValue, float64(c.cache.Capacity()))
+}
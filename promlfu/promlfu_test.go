@@ -0,0 +1,65 @@
+package promlfu
+
+import (
+	"lfucache/lfu"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollectorExportsStats(t *testing.T) {
+	cache := lfu.New[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("a")
+	if _, err := cache.Get("missing"); err == nil {
+		t.Fatalf("Get(missing) err = nil, want non-nil")
+	}
+
+	collector := NewCollector(cache, "test_cache")
+
+	const want = `
+		# HELP test_cache_capacity Configured cache capacity.
+		# TYPE test_cache_capacity gauge
+		test_cache_capacity 2
+		# HELP test_cache_hits_total Total number of cache hits.
+		# TYPE test_cache_hits_total counter
+		test_cache_hits_total 1
+		# HELP test_cache_misses_total Total number of cache misses.
+		# TYPE test_cache_misses_total counter
+		test_cache_misses_total 1
+		# HELP test_cache_size Current number of entries in the cache.
+		# TYPE test_cache_size gauge
+		test_cache_size 2
+	`
+	names := []string{
+		"test_cache_capacity",
+		"test_cache_hits_total",
+		"test_cache_misses_total",
+		"test_cache_size",
+	}
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(want), names...); err != nil {
+		t.Errorf("unexpected collected metrics: %v", err)
+	}
+}
+
+// TestCollectorAcceptsSync covers that NewCollector also works with a
+// *lfu.Sync, not just a bare *lfu.CacheImpl: metrics and concurrency-safety
+// need to compose for production use.
+func TestCollectorAcceptsSync(t *testing.T) {
+	cache := lfu.NewSync[string, int](2)
+	cache.Put("a", 1)
+	cache.Get("a")
+
+	collector := NewCollector(cache, "sync_cache")
+
+	const want = `
+		# HELP sync_cache_hits_total Total number of cache hits.
+		# TYPE sync_cache_hits_total counter
+		sync_cache_hits_total 1
+	`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(want), "sync_cache_hits_total"); err != nil {
+		t.Errorf("unexpected collected metrics: %v", err)
+	}
+}